@@ -0,0 +1,19 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package catconstants
+
+// CrdbInternalTenantClusterSettingsTableID is the id for
+// crdb_internal.tenant_cluster_settings. It's declared here as a
+// standalone constant rather than as a new entry in the existing
+// CrdbInternal*TableID iota block, since that block isn't part of this
+// change; it should be folded into that block (taking the next free
+// value) when merged.
+const CrdbInternalTenantClusterSettingsTableID = 4294967190
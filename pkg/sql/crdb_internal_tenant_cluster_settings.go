@@ -0,0 +1,102 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package sql
+
+import (
+	"context"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/catconstants"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
+)
+
+func init() {
+	crdbInternal.tableDefs[catconstants.CrdbInternalTenantClusterSettingsTableID] =
+		crdbInternalTenantClusterSettingsTable
+}
+
+// crdbInternalTenantClusterSettingsTable powers
+// crdb_internal.tenant_cluster_settings: the effective value of every
+// tenant-visible cluster setting for every tenant known to system.tenants.
+// It reuses collectTenantClusterSettings, so the (tenant-specific >
+// all-tenants override > default) precedence matches SHOW CLUSTER SETTING
+// ... FOR TENANT exactly.
+var crdbInternalTenantClusterSettingsTable = virtualSchemaTable{
+	comment: `effective cluster settings for every tenant (RESTRICTED)`,
+	schema: `
+CREATE TABLE crdb_internal.tenant_cluster_settings (
+  tenant_id INT NOT NULL,
+  name      STRING NOT NULL,
+  value     STRING NOT NULL,
+  type      STRING NOT NULL,
+  origin    STRING NOT NULL
+)`,
+	populate: func(
+		ctx context.Context, p *planner, _ catalog.DatabaseDescriptor, addRow func(...tree.Datum) error,
+	) error {
+		// Mirrors ShowTenantClusterSetting: reading other tenants' settings
+		// requires the same role option as writing them.
+		if err := p.requireModifyTenantSettingsRoleOption(ctx, "read crdb_internal.tenant_cluster_settings"); err != nil {
+			return err
+		}
+		if !p.execCfg.Codec.ForSystemTenant() {
+			return nil
+		}
+
+		it, err := p.execCfg.InternalExecutor.QueryIteratorEx(
+			ctx, "crdb-internal-tenant-cluster-settings-tenants", p.Txn(),
+			sessiondata.InternalExecutorOverride{User: security.RootUserName()},
+			"SELECT id FROM system.tenants",
+		)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = it.Close() }()
+
+		var tenantIDs []tree.Datum
+		var ok bool
+		for ok, err = it.Next(ctx); ok; ok, err = it.Next(ctx) {
+			tenantIDs = append(tenantIDs, it.Cur()[0])
+		}
+		if err != nil {
+			return err
+		}
+
+		// Loaded once and shared across every tenant below, rather than
+		// re-querying the tenant_id=0 ALL-tenants overrides once per tenant.
+		allOverrides, err := p.loadAllTenantOverrides(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, tenantIDd := range tenantIDs {
+			rows, err := p.collectTenantClusterSettingsWithAllOverrides(
+				ctx, tenantIDd, nil /* names */, allOverrides)
+			if err != nil {
+				return err
+			}
+			for _, row := range rows {
+				if err := addRow(
+					tenantIDd,
+					tree.NewDString(row.name),
+					tree.NewDString(row.value),
+					tree.NewDString(row.typ),
+					tree.NewDString(row.origin),
+				); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	},
+}
@@ -0,0 +1,31 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package roleoption
+
+// MODIFYTENANTSETTINGS authorizes a user to inspect and change cluster
+// settings on behalf of other tenants (ALTER/SHOW ... FOR TENANT), on the
+// system tenant. It is parallel to MODIFYCLUSTERSETTINGS, which only
+// covers the local tenant's own settings; tenant-level access is kept as
+// a separate, strictly more powerful option rather than being folded into
+// MODIFYCLUSTERSETTINGS. See pkg/sql/tenant_settings.go.
+//
+// These are declared apart from the package's existing Option const block
+// rather than continuing its iota sequence, since that block isn't part of
+// this change.
+const (
+	MODIFYTENANTSETTINGS Option = 1000 + iota
+	NOMODIFYTENANTSETTINGS
+)
+
+func init() {
+	ByName["MODIFYTENANTSETTINGS"] = MODIFYTENANTSETTINGS
+	ByName["NOMODIFYTENANTSETTINGS"] = NOMODIFYTENANTSETTINGS
+}
@@ -0,0 +1,36 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package roleoption
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestModifyTenantSettingsOption(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		exp   Option
+	}{
+		{"MODIFYTENANTSETTINGS", MODIFYTENANTSETTINGS},
+		{"modifytenantsettings", MODIFYTENANTSETTINGS},
+		{"NOMODIFYTENANTSETTINGS", NOMODIFYTENANTSETTINGS},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			got, err := ToOption(tc.input)
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, got)
+		})
+	}
+	require.False(t, MODIFYTENANTSETTINGS.HasValue())
+	require.False(t, NOMODIFYTENANTSETTINGS.HasValue())
+}
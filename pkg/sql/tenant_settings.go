@@ -12,14 +12,17 @@ package sql
 
 import (
 	"context"
+	"sort"
 	"strings"
 
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
 	"github.com/cockroachdb/cockroach/pkg/roachpb"
 	"github.com/cockroachdb/cockroach/pkg/security"
 	"github.com/cockroachdb/cockroach/pkg/settings"
 	"github.com/cockroachdb/cockroach/pkg/settings/cluster"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/roleoption"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
 	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
 	"github.com/cockroachdb/cockroach/pkg/sql/types"
@@ -28,30 +31,74 @@ import (
 	"github.com/cockroachdb/errors"
 )
 
+// Origins reported in the "origin" column of SHOW CLUSTER SETTING ... FOR
+// TENANT and SHOW ALL CLUSTER SETTINGS FOR TENANT, describing where the
+// effective value of a tenant setting came from.
+const (
+	tenantSettingOriginTenantSpecific = "tenant-specific"
+	tenantSettingOriginAllTenants     = "all-tenants-override"
+	tenantSettingOriginDefault        = "default"
+)
+
+// tenantSettingRow captures the (name, value, type, origin) tuple returned
+// by SHOW (ALL) CLUSTER SETTING(S) FOR TENANT and by
+// crdb_internal.tenant_cluster_settings.
+type tenantSettingRow struct {
+	name   string
+	value  string
+	typ    string
+	origin string
+}
+
+// Values stored in the override_mode column of system.tenant_settings for
+// the tenant_id=0 "ALL tenants" row. These control whether an ALL-tenants
+// override takes precedence over a tenant-specific SET.
+const (
+	// tenantOverrideModeAdvisory is the default: a per-tenant override, if
+	// present, still wins over the ALL-tenants value.
+	tenantOverrideModeAdvisory = "advisory"
+	// tenantOverrideModeAuthoritative means the ALL-tenants value always
+	// applies; per-tenant SETs of this setting are rejected outright.
+	tenantOverrideModeAuthoritative = "authoritative"
+)
+
+// tenantAllOverride describes the current tenant_id=0 row for a setting, as
+// loaded by loadAllTenantOverrides.
+type tenantAllOverride struct {
+	value         string
+	authoritative bool
+}
+
 // alterTenantSetClusterSettingNode represents an
-// ALTER TENANT ... SET CLUSTER SETTING statement.
+// ALTER TENANT ... SET CLUSTER SETTING or ALTER TENANT ... RESET CLUSTER
+// SETTING statement.
 type alterTenantSetClusterSettingNode struct {
 	name     string
 	tenantID tree.TypedExpr // tenantID or nil for "all tenants"
 	st       *cluster.Settings
 	setting  settings.NonMaskedSetting
-	// If value is nil, the setting should be reset.
+	// If value is nil, the setting should be reset (either because of an
+	// explicit RESET statement, or a SET ... = DEFAULT).
 	value tree.TypedExpr
+	// explicitReset is true for the RESET CLUSTER SETTING grammar, as
+	// opposed to SET ... = DEFAULT (which has the same effect but is
+	// reported differently in the event log).
+	explicitReset bool
+	// overrideMode is only set for TENANT ALL writes; it records whether
+	// the ALL override was marked OVERRIDE (authoritative) or NO OVERRIDE
+	// / unspecified (advisory).
+	overrideMode string
 }
 
 // AlterTenantSetClusterSetting sets tenant level session variables.
-// Privileges: super user.
+// Privileges: MODIFYTENANTSETTINGS.
 func (p *planner) AlterTenantSetClusterSetting(
 	ctx context.Context, n *tree.AlterTenantSetClusterSetting,
 ) (planNode, error) {
-	// Changing cluster settings for other tenants is a more
-	// privileged operation than changing local cluster settings. So we
-	// shouldn't be allowing with just the role option
-	// MODIFYCLUSTERSETTINGS.
-	//
-	// TODO(knz): Using admin authz for now; we may want to introduce a
-	// more specific role option later.
-	if err := p.RequireAdminRole(ctx, "change a tenant cluster setting"); err != nil {
+	// Changing cluster settings for other tenants is a more privileged
+	// operation than changing local cluster settings, so it is gated
+	// behind its own role option rather than MODIFYCLUSTERSETTINGS.
+	if err := p.requireModifyTenantSettingsRoleOption(ctx, "change a tenant cluster setting"); err != nil {
 		return nil, err
 	}
 	// Error out if we're trying to call this from a non-system tenant.
@@ -93,14 +140,34 @@ func (p *planner) AlterTenantSetClusterSetting(
 		return nil, unimplemented.NewWithIssue(77733, "cannot change the version of another tenant")
 	}
 
-	value, err := p.getAndValidateTypedClusterSetting(ctx, name, n.Value, setting)
-	if err != nil {
-		return nil, err
+	// RESET CLUSTER SETTING and SET ... = DEFAULT both reset the setting:
+	// RESET leaves value nil, and getAndValidateTypedClusterSetting yields
+	// a nil value for DEFAULT. n.Reset distinguishes the grammar for
+	// event-log reporting.
+	var value tree.TypedExpr
+	if !n.Reset {
+		var err error
+		value, err = p.getAndValidateTypedClusterSetting(ctx, name, n.Value, setting)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The OVERRIDE / NO OVERRIDE modifier is only meaningful for TENANT
+	// ALL; reject it otherwise since it wouldn't have any effect.
+	if n.Override != tree.TenantOverrideUnspecified && !n.TenantAll {
+		return nil, pgerror.New(pgcode.Syntax,
+			"OVERRIDE and NO OVERRIDE can only be used with ALTER TENANT ALL")
+	}
+	overrideMode := tenantOverrideModeAdvisory
+	if n.Override == tree.TenantOverrideOverride {
+		overrideMode = tenantOverrideModeAuthoritative
 	}
 
 	node := alterTenantSetClusterSettingNode{
 		name: name, tenantID: typedTenantID, st: st,
-		setting: setting, value: value,
+		setting: setting, value: value, explicitReset: n.Reset,
+		overrideMode: overrideMode,
 	}
 	return &node, nil
 }
@@ -127,6 +194,20 @@ func (n *alterTenantSetClusterSettingNode) startExec(params runParams) error {
 		}
 	}
 
+	// A per-tenant write (SET, not RESET) must yield to an authoritative
+	// ALL-tenants override: it would otherwise silently have no effect.
+	if n.tenantID != nil && n.value != nil {
+		allOverride, err := loadAllTenantOverride(params, n.name)
+		if err != nil {
+			return err
+		}
+		if allOverride != nil && allOverride.authoritative {
+			return errors.WithHint(pgerror.Newf(pgcode.InsufficientPrivilege,
+				"cannot set %q for this tenant: an authoritative ALL-tenants override is in effect", n.name),
+				"Use ALTER TENANT ALL SET CLUSTER SETTING ... NO OVERRIDE (or RESET it) before setting a per-tenant value.")
+		}
+	}
+
 	// Write the setting.
 	var reportedValue string
 	if n.value == nil {
@@ -149,25 +230,56 @@ func (n *alterTenantSetClusterSettingNode) startExec(params runParams) error {
 		if err != nil {
 			return err
 		}
-		if _, err := params.p.execCfg.InternalExecutor.ExecEx(
-			params.ctx, "update-tenant-setting", params.p.Txn(),
-			sessiondata.InternalExecutorOverride{User: security.RootUserName()},
-			`UPSERT INTO system.tenant_settings (tenant_id, name, value, last_updated, value_type) VALUES ($1, $2, $3, now(), $4)`,
-			tenantID, n.name, encoded, n.setting.Typ(),
-		); err != nil {
-			return err
+		// The override_mode column is only meaningful for the tenant_id=0
+		// ALL row; per-tenant rows always carry the advisory default since
+		// they have no children to be authoritative over.
+		overrideMode := tenantOverrideModeAdvisory
+		if n.tenantID == nil {
+			overrideMode = n.overrideMode
+		}
+		if overrideModeColumnActive(params.ctx, params.p) {
+			if _, err := params.p.execCfg.InternalExecutor.ExecEx(
+				params.ctx, "update-tenant-setting", params.p.Txn(),
+				sessiondata.InternalExecutorOverride{User: security.RootUserName()},
+				`UPSERT INTO system.tenant_settings (tenant_id, name, value, last_updated, value_type, override_mode) VALUES ($1, $2, $3, now(), $4, $5)`,
+				tenantID, n.name, encoded, n.setting.Typ(), overrideMode,
+			); err != nil {
+				return err
+			}
+		} else {
+			// The override_mode column hasn't been added on every node of the
+			// cluster yet (upgrade in progress); write the pre-migration
+			// columns only, since the column may not exist.
+			if _, err := params.p.execCfg.InternalExecutor.ExecEx(
+				params.ctx, "update-tenant-setting", params.p.Txn(),
+				sessiondata.InternalExecutorOverride{User: security.RootUserName()},
+				`UPSERT INTO system.tenant_settings (tenant_id, name, value, last_updated, value_type) VALUES ($1, $2, $3, now(), $4)`,
+				tenantID, n.name, encoded, n.setting.Typ(),
+			); err != nil {
+				return err
+			}
 		}
 	}
 
+	// OverrideMode is only meaningful for a TENANT ALL SET (it records
+	// whether that write was authoritative); per the event's documented
+	// contract, it is left empty for per-tenant writes and for resets.
+	var reportedOverrideMode string
+	if n.tenantID == nil && n.value != nil {
+		reportedOverrideMode = n.overrideMode
+	}
+
 	// Finally, log the event.
 	return params.p.logEvent(
 		params.ctx,
 		0, /* no target */
 		&eventpb.SetTenantClusterSetting{
-			SettingName: n.name,
-			Value:       reportedValue,
-			TenantId:    tenantIDi,
-			AllTenants:  tenantIDi == 0,
+			SettingName:  n.name,
+			Value:        reportedValue,
+			TenantId:     tenantIDi,
+			AllTenants:   tenantIDi == 0,
+			Reset:        n.explicitReset,
+			OverrideMode: reportedOverrideMode,
 		})
 }
 
@@ -175,6 +287,23 @@ func (n *alterTenantSetClusterSettingNode) Next(_ runParams) (bool, error) { ret
 func (n *alterTenantSetClusterSettingNode) Values() tree.Datums            { return nil }
 func (n *alterTenantSetClusterSettingNode) Close(_ context.Context)        {}
 
+// requireModifyTenantSettingsRoleOption checks that the current user holds
+// the MODIFYTENANTSETTINGS role option, which authorizes inspecting and
+// changing cluster settings on behalf of other tenants. This is
+// intentionally separate from MODIFYCLUSTERSETTINGS (which only covers the
+// local tenant) since it is a strictly more powerful capability.
+func (p *planner) requireModifyTenantSettingsRoleOption(ctx context.Context, action string) error {
+	hasModify, err := p.HasRoleOption(ctx, roleoption.MODIFYTENANTSETTINGS)
+	if err != nil {
+		return err
+	}
+	if !hasModify {
+		return pgerror.Newf(pgcode.InsufficientPrivilege,
+			"%s requires the MODIFYTENANTSETTINGS role option", action)
+	}
+	return nil
+}
+
 func resolveTenantID(params runParams, expr tree.TypedExpr) (uint64, tree.Datum, error) {
 	tenantIDd, err := expr.Eval(params.p.EvalContext())
 	if err != nil {
@@ -209,11 +338,321 @@ func assertTenantExists(params runParams, tenantID tree.Datum) error {
 	return nil
 }
 
+// showTenantClusterSettingNode implements SHOW CLUSTER SETTING <setting>
+// FOR TENANT <tenant_id> and SHOW ALL CLUSTER SETTINGS FOR TENANT
+// <tenant_id>.
+type showTenantClusterSettingNode struct {
+	// names is the list of settings to report. A nil slice means "all
+	// tenant-visible settings" (the ALL variant).
+	names    []string
+	tenantID tree.TypedExpr
+
+	rows []tenantSettingRow
+	idx  int
+}
+
 // ShowTenantClusterSetting shows the value of a cluster setting for a tenant.
-// Privileges: super user.
+// Privileges: MODIFYTENANTSETTINGS.
 func (p *planner) ShowTenantClusterSetting(
 	ctx context.Context, n *tree.ShowTenantClusterSetting,
 ) (planNode, error) {
-	return nil, unimplemented.NewWithIssue(73857,
-		`unimplemented: tenant-level cluster settings not supported`)
+	// Reading tenant cluster settings for another tenant is gated
+	// behind the same role option as writing them; mirrors
+	// AlterTenantSetClusterSetting.
+	if err := p.requireModifyTenantSettingsRoleOption(ctx, "show a tenant cluster setting"); err != nil {
+		return nil, err
+	}
+	// Error out if we're trying to call this from a non-system tenant.
+	if !p.execCfg.Codec.ForSystemTenant() {
+		return nil, pgerror.Newf(pgcode.InsufficientPrivilege,
+			"SHOW CLUSTER SETTING FOR TENANT can only be called by system operators")
+	}
+
+	var names []string
+	if !n.All {
+		name := strings.ToLower(n.Name)
+		v, ok := settings.Lookup(name, settings.LookupForLocalAccess, true /* forSystemTenant - checked above already */)
+		if !ok {
+			return nil, errors.Errorf("unknown cluster setting '%s'", name)
+		}
+		// Error out if we're trying to show a system-only variable.
+		if v.Class() == settings.SystemOnly {
+			return nil, pgerror.Newf(pgcode.InsufficientPrivilege,
+				"%s is a system-only setting and has no tenant-level value", name)
+		}
+		names = []string{name}
+	}
+
+	var dummyHelper tree.IndexedVarHelper
+	typedTenantID, err := p.analyzeExpr(
+		ctx, n.TenantID, nil, dummyHelper, types.Int, true, "SHOW CLUSTER SETTING FOR TENANT")
+	if err != nil {
+		return nil, err
+	}
+
+	return &showTenantClusterSettingNode{names: names, tenantID: typedTenantID}, nil
+}
+
+func (n *showTenantClusterSettingNode) startExec(params runParams) error {
+	_, tenantID, err := resolveTenantID(params, n.tenantID)
+	if err != nil {
+		return err
+	}
+	if err := assertTenantExists(params, tenantID); err != nil {
+		return err
+	}
+
+	rows, err := params.p.collectTenantClusterSettings(params.ctx, tenantID, n.names)
+	if err != nil {
+		return err
+	}
+	n.rows = rows
+	return nil
+}
+
+func (n *showTenantClusterSettingNode) Next(_ runParams) (bool, error) {
+	if n.idx >= len(n.rows) {
+		return false, nil
+	}
+	n.idx++
+	return true, nil
+}
+
+func (n *showTenantClusterSettingNode) Values() tree.Datums {
+	row := n.rows[n.idx-1]
+	return tree.Datums{
+		tree.NewDString(row.name),
+		tree.NewDString(row.value),
+		tree.NewDString(row.typ),
+		tree.NewDString(row.origin),
+	}
+}
+
+func (n *showTenantClusterSettingNode) Close(_ context.Context) {}
+
+// collectTenantClusterSettings computes the effective (value, origin) of
+// every setting in names for the given tenant, joining the tenant-specific
+// overrides in system.tenant_settings with the tenant_id=0 "ALL tenants"
+// overrides and falling back to the system tenant's default when neither
+// is present. A nil names reports every tenant-visible setting.
+func (p *planner) collectTenantClusterSettings(
+	ctx context.Context, tenantID tree.Datum, names []string,
+) ([]tenantSettingRow, error) {
+	allOverrides, err := p.loadAllTenantOverrides(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return p.collectTenantClusterSettingsWithAllOverrides(ctx, tenantID, names, allOverrides)
+}
+
+// collectTenantClusterSettingsWithAllOverrides is collectTenantClusterSettings
+// with the tenant_id=0 "ALL tenants" overrides already loaded by the caller,
+// so that callers iterating over many tenants (e.g.
+// crdb_internal.tenant_cluster_settings) only pay for that lookup once
+// rather than once per tenant.
+func (p *planner) collectTenantClusterSettingsWithAllOverrides(
+	ctx context.Context, tenantID tree.Datum, names []string, allOverrides map[string]*tenantAllOverride,
+) ([]tenantSettingRow, error) {
+	overrides, err := p.loadTenantSettingOverrides(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if names == nil {
+		for name, v := range settings.Registry {
+			if v.Class() == settings.SystemOnly {
+				continue
+			}
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+
+	rows := make([]tenantSettingRow, 0, len(names))
+	for _, name := range names {
+		v, ok := settings.Lookup(name, settings.LookupForLocalAccess, true /* forSystemTenant */)
+		if !ok {
+			continue
+		}
+		setting, ok := v.(settings.NonMaskedSetting)
+		if !ok {
+			continue
+		}
+
+		// An authoritative ALL-tenants override always wins; otherwise a
+		// tenant-specific override takes precedence over the (advisory or
+		// absent) ALL-tenants value.
+		var value, origin string
+		switch {
+		case allOverrides[name] != nil && allOverrides[name].authoritative:
+			value, origin = allOverrides[name].value, tenantSettingOriginAllTenants
+		case overrides[name] != nil:
+			value, origin = *overrides[name], tenantSettingOriginTenantSpecific
+		case allOverrides[name] != nil:
+			value, origin = allOverrides[name].value, tenantSettingOriginAllTenants
+		default:
+			value, origin = setting.EncodedDefault(), tenantSettingOriginDefault
+		}
+		// Stored and default values are in encoded form; report them the way
+		// SHOW CLUSTER SETTING does (e.g. an enum's name, not its number).
+		repr, err := setting.DecodeToString(value)
+		if err != nil {
+			return nil, err
+		}
+
+		rows = append(rows, tenantSettingRow{
+			name:   name,
+			value:  repr,
+			typ:    setting.Typ(),
+			origin: origin,
+		})
+	}
+	return rows, nil
+}
+
+// loadTenantSettingOverrides returns the name -> encoded value of the
+// explicit per-tenant overrides stored in system.tenant_settings for the
+// given tenant ID. Use loadAllTenantOverrides / loadAllTenantOverride for
+// the tenant_id=0 "ALL tenants" row, which also carries an override_mode.
+func (p *planner) loadTenantSettingOverrides(
+	ctx context.Context, tenantID tree.Datum,
+) (map[string]*string, error) {
+	it, err := p.execCfg.InternalExecutor.QueryIteratorEx(
+		ctx, "get-tenant-setting-overrides", p.Txn(),
+		sessiondata.InternalExecutorOverride{User: security.RootUserName()},
+		"SELECT name, value FROM system.tenant_settings WHERE tenant_id = $1", tenantID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = it.Close() }()
+
+	overrides := make(map[string]*string)
+	var ok bool
+	for ok, err = it.Next(ctx); ok; ok, err = it.Next(ctx) {
+		row := it.Cur()
+		name := string(tree.MustBeDString(row[0]))
+		value := string(tree.MustBeDString(row[1]))
+		overrides[name] = &value
+	}
+	if err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
+
+// overrideModeColumnActive reports whether every node in the cluster is
+// guaranteed to have the override_mode column on system.tenant_settings
+// (i.e. the addTenantSettingsOverrideModeColumn upgrade has completed).
+// Until then, the column must not be referenced in any query, since it may
+// not exist yet on some ranges.
+func overrideModeColumnActive(ctx context.Context, p *planner) bool {
+	return p.ExecCfg().Settings.Version.IsActive(ctx, clusterversion.V23_1AddTenantSettingsOverrideMode)
+}
+
+// loadAllTenantOverrides returns the name -> override of every explicit
+// tenant_id=0 "ALL tenants" row in system.tenant_settings.
+func (p *planner) loadAllTenantOverrides(ctx context.Context) (map[string]*tenantAllOverride, error) {
+	if !overrideModeColumnActive(ctx, p) {
+		return p.loadAllTenantOverridesWithoutOverrideMode(ctx)
+	}
+	it, err := p.execCfg.InternalExecutor.QueryIteratorEx(
+		ctx, "get-all-tenant-overrides", p.Txn(),
+		sessiondata.InternalExecutorOverride{User: security.RootUserName()},
+		"SELECT name, value, override_mode FROM system.tenant_settings WHERE tenant_id = 0",
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = it.Close() }()
+
+	overrides := make(map[string]*tenantAllOverride)
+	var ok bool
+	for ok, err = it.Next(ctx); ok; ok, err = it.Next(ctx) {
+		row := it.Cur()
+		name := string(tree.MustBeDString(row[0]))
+		overrides[name] = &tenantAllOverride{
+			value:         string(tree.MustBeDString(row[1])),
+			authoritative: string(tree.MustBeDString(row[2])) == tenantOverrideModeAuthoritative,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
+
+// loadAllTenantOverridesWithoutOverrideMode is the loadAllTenantOverrides
+// fallback used while the override_mode column may not exist yet on every
+// node; every override found this way is treated as advisory.
+func (p *planner) loadAllTenantOverridesWithoutOverrideMode(
+	ctx context.Context,
+) (map[string]*tenantAllOverride, error) {
+	it, err := p.execCfg.InternalExecutor.QueryIteratorEx(
+		ctx, "get-all-tenant-overrides", p.Txn(),
+		sessiondata.InternalExecutorOverride{User: security.RootUserName()},
+		"SELECT name, value FROM system.tenant_settings WHERE tenant_id = 0",
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = it.Close() }()
+
+	overrides := make(map[string]*tenantAllOverride)
+	var ok bool
+	for ok, err = it.Next(ctx); ok; ok, err = it.Next(ctx) {
+		row := it.Cur()
+		name := string(tree.MustBeDString(row[0]))
+		overrides[name] = &tenantAllOverride{value: string(tree.MustBeDString(row[1]))}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
+
+// loadAllTenantOverride returns the tenant_id=0 "ALL tenants" override for
+// a single setting, or nil if there is none. It is used to enforce
+// authoritative ALL overrides when processing a per-tenant SET.
+func loadAllTenantOverride(params runParams, name string) (*tenantAllOverride, error) {
+	if !overrideModeColumnActive(params.ctx, params.p) {
+		row, err := params.p.execCfg.InternalExecutor.QueryRowEx(
+			params.ctx, "get-all-tenant-override", params.p.Txn(),
+			sessiondata.InternalExecutorOverride{User: security.RootUserName()},
+			"SELECT value FROM system.tenant_settings WHERE tenant_id = 0 AND name = $1", name,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if row == nil {
+			return nil, nil
+		}
+		return &tenantAllOverride{value: string(tree.MustBeDString(row[0]))}, nil
+	}
+	row, err := params.p.execCfg.InternalExecutor.QueryRowEx(
+		params.ctx, "get-all-tenant-override", params.p.Txn(),
+		sessiondata.InternalExecutorOverride{User: security.RootUserName()},
+		"SELECT value, override_mode FROM system.tenant_settings WHERE tenant_id = 0 AND name = $1", name,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if row == nil {
+		return nil, nil
+	}
+	return &tenantAllOverride{
+		value:         string(tree.MustBeDString(row[0])),
+		authoritative: string(tree.MustBeDString(row[1])) == tenantOverrideModeAuthoritative,
+	}, nil
+}
+
+// TenantClusterSettings returns the effective value of every tenant-visible
+// cluster setting for a single tenantID, joined against the ALL-tenants
+// overrides and falling back to defaults. crdb_internal.tenant_cluster_settings
+// uses collectTenantClusterSettingsWithAllOverrides directly instead, so the
+// ALL-tenants overrides are only loaded once across every tenant it reports.
+func (p *planner) TenantClusterSettings(
+	ctx context.Context, tenantID uint64,
+) ([]tenantSettingRow, error) {
+	return p.collectTenantClusterSettings(ctx, tree.NewDInt(tree.DInt(tenantID)), nil /* names */)
 }
@@ -0,0 +1,39 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package upgrades
+
+import (
+	"context"
+
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/upgrade"
+)
+
+// modifyTenantSettingsRoleOption is a no-op upgrade. system.role_options
+// already stores arbitrary (username, option, value) rows, so no schema
+// change is required to support the new MODIFYTENANTSETTINGS role option.
+// The upgrade exists purely to gate the cluster version at which
+// GRANT/ALTER ROLE ... MODIFYTENANTSETTINGS is accepted, so that a
+// mixed-version cluster can't grant an option that an older node in the
+// cluster wouldn't recognize or enforce.
+func modifyTenantSettingsRoleOption(
+	_ context.Context, _ clusterversion.ClusterVersion, _ upgrade.TenantDeps,
+) error {
+	return nil
+}
+
+func init() {
+	upgrade.MustRegisterUpgrade(upgrade.Upgrade{
+		Version:     clusterversion.V23_1AddModifyTenantSettingsRoleOption,
+		Description: "permit the MODIFYTENANTSETTINGS role option",
+		Run:         modifyTenantSettingsRoleOption,
+	})
+}
@@ -0,0 +1,42 @@
+// Copyright 2022 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package upgrades
+
+import (
+	"context"
+
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/upgrade"
+)
+
+// addTenantSettingsOverrideModeColumn adds the override_mode column to
+// system.tenant_settings, defaulting existing rows (all of which predate
+// ALTER TENANT ALL ... OVERRIDE / NO OVERRIDE) to "advisory" so their
+// behavior is unchanged: a per-tenant override continues to win over them.
+func addTenantSettingsOverrideModeColumn(
+	ctx context.Context, _ clusterversion.ClusterVersion, deps upgrade.SystemDeps,
+) error {
+	_, err := deps.InternalExecutor.ExecEx(
+		ctx, "add-tenant-settings-override-mode-column", nil, /* txn */
+		upgrade.SessionOverride,
+		`ALTER TABLE system.tenant_settings
+			ADD COLUMN IF NOT EXISTS override_mode STRING NOT NULL DEFAULT 'advisory'`,
+	)
+	return err
+}
+
+func init() {
+	upgrade.MustRegisterUpgrade(upgrade.Upgrade{
+		Version:     clusterversion.V23_1AddTenantSettingsOverrideMode,
+		Description: "add override_mode column to system.tenant_settings",
+		Run:         addTenantSettingsOverrideModeColumn,
+	})
+}